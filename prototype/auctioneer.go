@@ -0,0 +1,148 @@
+package prototype
+
+import (
+	"context"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/offchainlabs/timeboost-design-docs/bindings"
+)
+
+// Auctioneer resolves express lane auctions for one or more chains that
+// share the same ExpressLaneAuction contract deployment style. A single
+// auctioneer process can therefore accept bids destined for any L2/Orbit
+// chain in its accepted set, rather than binding to just one chain id.
+type Auctioneer struct {
+	client              *ethclient.Client
+	auctionContractAddr common.Address
+	auctionContract     *bindings.ExpressLaneAuction
+	acceptedChainIDs    []uint64
+	registeredBidders   map[common.Address]struct{}
+}
+
+// NewAuctioneer constructs an auctioneer that only accepts bids destined
+// for one of the given chain ids and signed by one of the given registered
+// bidder addresses.
+func NewAuctioneer(
+	client *ethclient.Client, auctionContractAddr common.Address, acceptedChainIDs []uint64, registeredBidders []common.Address,
+) (*Auctioneer, error) {
+	if len(acceptedChainIDs) == 0 {
+		return nil, fmt.Errorf("must configure at least one accepted chain id")
+	}
+	if len(registeredBidders) == 0 {
+		return nil, fmt.Errorf("must configure at least one registered bidder")
+	}
+	auctionContract, err := bindings.NewExpressLaneAuction(auctionContractAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("could not bind to express lane auction contract: %w", err)
+	}
+	bidders := make(map[common.Address]struct{}, len(registeredBidders))
+	for _, addr := range registeredBidders {
+		bidders[addr] = struct{}{}
+	}
+	return &Auctioneer{
+		client:              client,
+		auctionContractAddr: auctionContractAddr,
+		auctionContract:     auctionContract,
+		acceptedChainIDs:    append([]uint64(nil), acceptedChainIDs...),
+		registeredBidders:   bidders,
+	}, nil
+}
+
+// acceptsChainID reports whether the auctioneer is configured to accept
+// bids for the given chain id. It compares against every configured id
+// with subtle.ConstantTimeCompare and never exits early on a match, so the
+// time it takes does not depend on whether, or at what position, chainID
+// is found in the accepted set.
+func (a *Auctioneer) acceptsChainID(chainID uint64) bool {
+	target := make([]byte, 8)
+	binary.BigEndian.PutUint64(target, chainID)
+	candidate := make([]byte, 8)
+	found := 0
+	for _, id := range a.acceptedChainIDs {
+		binary.BigEndian.PutUint64(candidate, id)
+		found |= subtle.ConstantTimeCompare(target, candidate)
+	}
+	return found == 1
+}
+
+// isRegisteredBidder reports whether addr is one of the auctioneer's known
+// bidders. validateBid rejects any bid whose signature recovers to an
+// address outside this set, so a forged bid, or one whose signing domain
+// happens to recover to an unrelated address (e.g. a cross-chain replay),
+// cannot be attributed to a bidder the auctioneer never onboarded.
+func (a *Auctioneer) isRegisteredBidder(addr common.Address) bool {
+	_, ok := a.registeredBidders[addr]
+	return ok
+}
+
+// validateBid checks that a signed bid is destined for one of this
+// auctioneer's accepted chains and that its signature recovers to a
+// valid bidder address under the auction contract's signing domain.
+func (a *Auctioneer) validateBid(ctx context.Context, bid *SignedBid) (common.Address, error) {
+	if bid.Amount == nil {
+		return common.Address{}, fmt.Errorf("bid amount is missing")
+	}
+	if len(bid.Signature) == 0 {
+		return common.Address{}, fmt.Errorf("bid signature is missing")
+	}
+	if bid.ChainId == nil || !bid.ChainId.IsUint64() {
+		return common.Address{}, fmt.Errorf("bid chain id is not a valid uint64")
+	}
+	chainID := bid.ChainId.Uint64()
+	if !a.acceptsChainID(chainID) {
+		return common.Address{}, fmt.Errorf("auctioneer does not accept bids for chain id %d", chainID)
+	}
+	signer, err := recoverBidSigner(bid, a.auctionContractAddr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("could not recover bid signer: %w", err)
+	}
+	if !a.isRegisteredBidder(signer) {
+		return common.Address{}, fmt.Errorf("recovered signer %s is not a registered bidder", signer)
+	}
+	return signer, nil
+}
+
+// ResolveRound picks the highest and second-highest validly signed bids
+// submitted for round and submits the on-chain resolution that makes the
+// highest bidder the express lane controller for that round while
+// charging it the second-highest bid amount. It returns a nil transaction
+// and no error if fewer than two valid bids were collected for the
+// round, in which case the round resolves to no controller.
+func (a *Auctioneer) ResolveRound(ctx context.Context, opts *bind.TransactOpts, round uint64, bids []*SignedBid) (*types.Transaction, error) {
+	valid := make([]*SignedBid, 0, len(bids))
+	for _, bid := range bids {
+		if bid.Round != round {
+			continue
+		}
+		if _, err := a.validateBid(ctx, bid); err != nil {
+			continue
+		}
+		valid = append(valid, bid)
+	}
+	if len(valid) < 2 {
+		return nil, nil
+	}
+	sort.Slice(valid, func(i, j int) bool {
+		return valid[i].Amount.Cmp(valid[j].Amount) > 0
+	})
+	winner := valid[0]
+	secondPrice := valid[1].Amount
+	winnerAddr, err := recoverBidSigner(winner, a.auctionContractAddr)
+	if err != nil {
+		return nil, fmt.Errorf("could not recover winning bidder: %w", err)
+	}
+	tx, err := a.auctionContract.ResolveMultiBidAuction(
+		opts, round, winnerAddr, winner.ExpressLaneController, secondPrice,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("could not resolve round %d: %w", round, err)
+	}
+	return tx, nil
+}
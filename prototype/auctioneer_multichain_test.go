@@ -0,0 +1,72 @@
+package prototype
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+// TestAuctioneerAcceptsMultipleChains verifies that a single auctioneer
+// instance configured with two accepted chain ids validates bids signed
+// for either chain, but rejects a bid signed for one chain when it is
+// replayed as if it were destined for the other.
+func TestAuctioneerAcceptsMultipleChains(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	setupA := setupAuctionTestForChain(t, ctx, 1337)
+	setupB := setupAuctionTestForChain(t, ctx, 1338)
+	require.Equal(
+		t, setupA.auctioneerAddr, setupB.auctioneerAddr,
+		"both chains must share the same auction contract deployment for a bid to carry a consistent verifyingContract domain",
+	)
+
+	auctioneer, err := NewAuctioneer(
+		setupA.backend.Client(),
+		setupA.auctioneerAddr,
+		[]uint64{setupA.chainId.Uint64(), setupB.chainId.Uint64()},
+		[]common.Address{setupA.accounts[1].accountAddr, setupB.accounts[1].accountAddr},
+	)
+	require.NoError(t, err)
+
+	bidderA := setupBidderClient(t, ctx, "alice", setupA.accounts[1], setupA)
+	require.Equal(t, setupA.chainId.Uint64(), bidderA.ChainId().Uint64())
+
+	bidderB := setupBidderClient(t, ctx, "bob", setupB.accounts[1], setupB)
+	require.Equal(t, setupB.chainId.Uint64(), bidderB.ChainId().Uint64())
+
+	deadline := time.Now().Add(time.Minute)
+	bidA, err := bidderA.signBid(0, big.NewInt(5), setupA.expressLaneAddr, deadline)
+	require.NoError(t, err)
+	signerA, err := auctioneer.validateBid(ctx, bidA)
+	require.NoError(t, err)
+	require.Equal(t, setupA.accounts[1].accountAddr, signerA)
+
+	bidB, err := bidderB.signBid(0, big.NewInt(5), setupB.expressLaneAddr, deadline)
+	require.NoError(t, err)
+	signerB, err := auctioneer.validateBid(ctx, bidB)
+	require.NoError(t, err)
+	require.Equal(t, setupB.accounts[1].accountAddr, signerB)
+
+	// Replaying chain A's bid tagged as if it were for an unconfigured
+	// chain must be rejected outright.
+	replayed := *bidA
+	replayed.ChainId = big.NewInt(999)
+	_, err = auctioneer.validateBid(ctx, &replayed)
+	require.Error(t, err)
+
+	// A bid genuinely signed for chain A does not recover to the same
+	// signer when checked as if it were destined for chain B's auction
+	// deployment, since the signing domain differs, so it recovers to
+	// some unrelated address rather than alice's. The auctioneer must
+	// reject it outright rather than silently attributing it to whatever
+	// address the mismatched domain happens to recover to.
+	crossChain := *bidA
+	crossChain.ChainId = setupB.chainId
+	_, err = auctioneer.validateBid(ctx, &crossChain)
+	require.Error(t, err)
+}
@@ -0,0 +1,158 @@
+package prototype
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"golang.org/x/time/rate"
+)
+
+// RoundInfo describes the round the auctioneer server is currently
+// collecting bids for, so a bidder knows which round to bid into.
+type RoundInfo struct {
+	Round            uint64        `json:"round"`
+	RoundDuration    time.Duration `json:"roundDurationNanos"`
+	InitialTimestamp time.Time     `json:"initialTimestamp"`
+}
+
+// AuctioneerServer is an off-chain HTTP front-end for an Auctioneer.
+// Bidders submit signed bids to it instead of racing each other on-chain;
+// the server collects bids per round and only ever submits the winning
+// and second-highest resolution as a single on-chain transaction once the
+// round is resolved.
+type AuctioneerServer struct {
+	auctioneer       *Auctioneer
+	initialTimestamp time.Time
+	roundDuration    time.Duration
+
+	mu          sync.Mutex
+	lastNonce   map[common.Address]uint64
+	limiters    map[common.Address]*rate.Limiter
+	bidsByRound map[uint64][]*SignedBid
+}
+
+// NewAuctioneerServer wraps auctioneer with an HTTP bid-collection front
+// end. initialTimestamp and roundDuration must match the auction
+// contract's own round schedule.
+func NewAuctioneerServer(auctioneer *Auctioneer, initialTimestamp time.Time, roundDuration time.Duration) *AuctioneerServer {
+	return &AuctioneerServer{
+		auctioneer:       auctioneer,
+		initialTimestamp: initialTimestamp,
+		roundDuration:    roundDuration,
+		lastNonce:        make(map[common.Address]uint64),
+		limiters:         make(map[common.Address]*rate.Limiter),
+		bidsByRound:      make(map[uint64][]*SignedBid),
+	}
+}
+
+// currentRound computes which round now falls within, using the same
+// initialTimestamp + roundDuration*n formula as the auction contract.
+func (s *AuctioneerServer) currentRound(now time.Time) uint64 {
+	if now.Before(s.initialTimestamp) {
+		return 0
+	}
+	return uint64(now.Sub(s.initialTimestamp) / s.roundDuration)
+}
+
+// limiterFor returns the rate limiter tracking bid submissions from addr,
+// creating one bounded to a modest steady rate with a small burst so a
+// single bidder can't flood the server with bids.
+func (s *AuctioneerServer) limiterFor(addr common.Address) *rate.Limiter {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	limiter, ok := s.limiters[addr]
+	if !ok {
+		limiter = rate.NewLimiter(rate.Every(time.Second), 5)
+		s.limiters[addr] = limiter
+	}
+	return limiter
+}
+
+// submitBid validates and records a signed bid for later resolution. It
+// rejects malformed bids (unaccepted chain id or bad signature), expired
+// bids (past their deadline), bids for rounds that have already closed,
+// replayed bids (a nonce at or below one already seen from that bidder),
+// and bids from a bidder that has exceeded its submission rate limit.
+func (s *AuctioneerServer) submitBid(ctx context.Context, bid *SignedBid) error {
+	signer, err := s.auctioneer.validateBid(ctx, bid)
+	if err != nil {
+		return fmt.Errorf("invalid bid: %w", err)
+	}
+	if !s.limiterFor(signer).Allow() {
+		return fmt.Errorf("bidder %s exceeded its bid submission rate limit", signer)
+	}
+	now := time.Now()
+	if bid.Deadline != 0 && now.Unix() > int64(bid.Deadline) {
+		return fmt.Errorf("bid deadline has passed")
+	}
+	if bid.Round < s.currentRound(now) {
+		return fmt.Errorf("bid is for a round that has already closed")
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if last, ok := s.lastNonce[signer]; ok && bid.Nonce <= last {
+		return fmt.Errorf("bid nonce %d has already been used or replayed", bid.Nonce)
+	}
+	s.lastNonce[signer] = bid.Nonce
+	s.bidsByRound[bid.Round] = append(s.bidsByRound[bid.Round], bid)
+	return nil
+}
+
+// getRoundInfo reports the round currently accepting bids.
+func (s *AuctioneerServer) getRoundInfo() RoundInfo {
+	return RoundInfo{
+		Round:            s.currentRound(time.Now()),
+		RoundDuration:    s.roundDuration,
+		InitialTimestamp: s.initialTimestamp,
+	}
+}
+
+// ResolveRound resolves the given round on-chain using every bid
+// collected for it so far, then clears that round's bid buffer.
+func (s *AuctioneerServer) ResolveRound(ctx context.Context, opts *bind.TransactOpts, round uint64) (*types.Transaction, error) {
+	s.mu.Lock()
+	bids := s.bidsByRound[round]
+	delete(s.bidsByRound, round)
+	s.mu.Unlock()
+	return s.auctioneer.ResolveRound(ctx, opts, round, bids)
+}
+
+// ServeHTTP implements a minimal JSON-RPC-style surface: POST
+// /submitBid with a JSON-encoded SignedBid body, and GET /getRoundInfo.
+func (s *AuctioneerServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch r.URL.Path {
+	case "/submitBid":
+		if r.Method != http.MethodPost {
+			http.Error(w, "submitBid requires POST", http.StatusMethodNotAllowed)
+			return
+		}
+		var bid SignedBid
+		if err := json.NewDecoder(r.Body).Decode(&bid); err != nil {
+			http.Error(w, fmt.Sprintf("malformed bid payload: %v", err), http.StatusBadRequest)
+			return
+		}
+		if err := s.submitBid(r.Context(), &bid); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	case "/getRoundInfo":
+		if r.Method != http.MethodGet {
+			http.Error(w, "getRoundInfo requires GET", http.StatusMethodNotAllowed)
+			return
+		}
+		if err := json.NewEncoder(w).Encode(s.getRoundInfo()); err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		}
+	default:
+		http.NotFound(w, r)
+	}
+}
@@ -0,0 +1,129 @@
+package prototype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+)
+
+func setupAuctioneerServerTest(t *testing.T, ctx context.Context) (*auctionSetup, *httptest.Server, string) {
+	testSetup := setupAuctionTest(t, ctx)
+	registeredBidders := make([]common.Address, 0, len(testSetup.accounts)-1)
+	for _, acc := range testSetup.accounts[1:] {
+		registeredBidders = append(registeredBidders, acc.accountAddr)
+	}
+	auctioneer, err := NewAuctioneer(
+		testSetup.backend.Client(), testSetup.auctioneerAddr, []uint64{testSetup.chainId.Uint64()}, registeredBidders,
+	)
+	require.NoError(t, err)
+	server := NewAuctioneerServer(auctioneer, testSetup.initialTimestamp, testSetup.roundDuration)
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+	return testSetup, httpServer, httpServer.URL
+}
+
+func TestAuctioneerServer_SubmitBid(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testSetup, _, endpoint := setupAuctioneerServerTest(t, ctx)
+	bidder := setupBidderClient(t, ctx, "alice", testSetup.accounts[1], testSetup)
+	bidder.auctioneerEndpoint = &endpoint
+	bidder.httpClient = http.DefaultClient
+
+	t.Run("accepts a well-formed bid", func(t *testing.T) {
+		_, err := bidder.SubmitBid(ctx, 0, big.NewInt(5), testSetup.expressLaneAddr, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects a malformed bid", func(t *testing.T) {
+		resp, err := http.Post(endpoint+"/submitBid", "application/json", bytes.NewReader([]byte("not json")))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("rejects a structurally-valid but incomplete bid", func(t *testing.T) {
+		// No amount, and no signature: valid JSON, but missing the fields
+		// validateBid needs before it can even attempt signature
+		// recovery. This must be rejected cleanly, not panic the server.
+		payload, err := json.Marshal(map[string]any{
+			"ChainId": testSetup.chainId,
+			"Round":   uint64(0),
+		})
+		require.NoError(t, err)
+		resp, err := http.Post(endpoint+"/submitBid", "application/json", bytes.NewReader(payload))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("rejects a replayed bid", func(t *testing.T) {
+		bid, err := bidder.signBid(1, big.NewInt(5), testSetup.expressLaneAddr, time.Now().Add(time.Minute))
+		require.NoError(t, err)
+		payload, err := json.Marshal(bid)
+		require.NoError(t, err)
+
+		resp, err := http.Post(endpoint+"/submitBid", "application/json", bytes.NewReader(payload))
+		require.NoError(t, err)
+		resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+
+		replay, err := http.Post(endpoint+"/submitBid", "application/json", bytes.NewReader(payload))
+		require.NoError(t, err)
+		defer replay.Body.Close()
+		require.Equal(t, http.StatusBadRequest, replay.StatusCode)
+	})
+
+	t.Run("rejects an expired bid", func(t *testing.T) {
+		bid, err := bidder.signBid(2, big.NewInt(5), testSetup.expressLaneAddr, time.Now().Add(-time.Minute))
+		require.NoError(t, err)
+		payload, err := json.Marshal(bid)
+		require.NoError(t, err)
+
+		resp, err := http.Post(endpoint+"/submitBid", "application/json", bytes.NewReader(payload))
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusBadRequest, resp.StatusCode)
+	})
+
+	t.Run("getRoundInfo reports the current round", func(t *testing.T) {
+		before := uint64(time.Since(testSetup.initialTimestamp) / testSetup.roundDuration)
+		resp, err := http.Get(endpoint + "/getRoundInfo")
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		require.Equal(t, http.StatusOK, resp.StatusCode)
+		var info RoundInfo
+		require.NoError(t, json.NewDecoder(resp.Body).Decode(&info))
+		after := uint64(time.Since(testSetup.initialTimestamp) / testSetup.roundDuration)
+		require.GreaterOrEqual(t, info.Round, before)
+		require.LessOrEqual(t, info.Round, after)
+	})
+}
+
+func TestAuctioneerServer_RateLimitsPerBidder(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	testSetup, _, endpoint := setupAuctioneerServerTest(t, ctx)
+	bidder := setupBidderClient(t, ctx, "alice", testSetup.accounts[1], testSetup)
+	bidder.auctioneerEndpoint = &endpoint
+	bidder.httpClient = http.DefaultClient
+
+	var lastErr error
+	for i := 0; i < 20; i++ {
+		if _, err := bidder.SubmitBid(ctx, 0, big.NewInt(5), testSetup.expressLaneAddr, time.Now().Add(time.Minute)); err != nil {
+			lastErr = err
+			break
+		}
+	}
+	require.Error(t, lastErr, "a bidder submitting bids in a tight loop should eventually be rate limited")
+}
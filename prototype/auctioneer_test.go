@@ -1,16 +1,167 @@
 package prototype
 
 import (
+	"context"
+	"fmt"
+	"math/big"
+	"math/rand"
 	"testing"
-)
-
-type mockSequencer struct{}
+	"time"
 
-// TODO: Mock sequencer subscribes to auction resolution events to
-// figure out who is the upcoming express lane auction controller and allows
-// sequencing of txs from that controller in their given round.
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/require"
+)
 
 // Runs a simulation of an express lane auction between different parties,
 // with some rounds randomly being canceled due to sequencer downtime.
 func TestCompleteAuctionSimulation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// A short round duration keeps the test's wall-clock rounds aligned
+	// with the contract's own round schedule without the test needing to
+	// run for minutes at a time.
+	const testRoundDuration = 4 * time.Second
+	testSetup := setupAuctionTestForChainAndRound(t, ctx, 1337, testRoundDuration)
+
+	const numBidders = 4
+	const numRounds = 5
+	registeredBidders := make([]common.Address, numBidders)
+	for i := 0; i < numBidders; i++ {
+		registeredBidders[i] = testSetup.accounts[i+1].accountAddr
+	}
+	auctioneer, err := NewAuctioneer(
+		testSetup.backend.Client(), testSetup.auctioneerAddr, []uint64{testSetup.chainId.Uint64()}, registeredBidders,
+	)
+	require.NoError(t, err)
+	seq, err := NewSequencer(
+		ctx, testSetup.backend.Client(), testSetup.auctioneerAddr, testSetup.initialTimestamp, testSetup.roundDuration,
+	)
+	require.NoError(t, err)
+
+	bidders := make([]*BidderClient, numBidders)
+	for i := 0; i < numBidders; i++ {
+		bidders[i] = setupBidderClient(t, ctx, fmt.Sprintf("bidder-%d", i), testSetup.accounts[i+1], testSetup)
+	}
+
+	rng := rand.New(rand.NewSource(1))
+	totalDebited := new(big.Int)
+	maxApproval := new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+	for i := 0; i < numRounds; i++ {
+		// Key this iteration's round off the same wall-clock formula the
+		// contract and sequencer use, rather than the loop counter, so
+		// bids and resolutions always target the round actually in
+		// progress.
+		round := seq.roundForTimestamp(time.Now())
+		canceled := rng.Intn(3) == 0
+
+		startBalances := make([]*big.Int, numBidders)
+		for i, acc := range testSetup.accounts[1 : numBidders+1] {
+			bal, err := testSetup.erc20Contract.BalanceOf(&bind.CallOpts{}, acc.accountAddr)
+			require.NoError(t, err)
+			startBalances[i] = bal
+		}
+
+		if canceled {
+			// Simulate sequencer downtime by actually halting block
+			// production for longer than a full round, rather than just
+			// skipping the resolution call: any bid or resolution
+			// transaction submitted during this window is not mined until
+			// commits resume, by which time the round has ended.
+			testSetup.pauseCommits()
+			time.Sleep(testSetup.roundDuration + time.Second)
+			testSetup.resumeCommits()
+
+			for i, acc := range testSetup.accounts[1 : numBidders+1] {
+				bal, err := testSetup.erc20Contract.BalanceOf(&bind.CallOpts{}, acc.accountAddr)
+				require.NoError(t, err)
+				require.Equal(t, 0, bal.Cmp(startBalances[i]), "canceled round must not move funds")
+			}
+			_, known := seq.Controller(round)
+			require.False(t, known, "canceled round must resolve no express lane controller")
+			continue
+		}
+
+		bids := make([]*SignedBid, numBidders)
+		for i, bidder := range bidders {
+			amount := big.NewInt(int64(rng.Intn(100) + 1))
+			bid, err := bidder.signBid(round, amount, testSetup.expressLaneAddr, time.Now().Add(testRoundDuration))
+			require.NoError(t, err)
+			bids[i] = bid
+		}
+		highestIdx, secondHighestIdx := 0, 1
+		if bids[secondHighestIdx].Amount.Cmp(bids[highestIdx].Amount) > 0 {
+			highestIdx, secondHighestIdx = secondHighestIdx, highestIdx
+		}
+		for i := 2; i < numBidders; i++ {
+			if bids[i].Amount.Cmp(bids[highestIdx].Amount) > 0 {
+				highestIdx, secondHighestIdx = i, highestIdx
+			} else if bids[i].Amount.Cmp(bids[secondHighestIdx].Amount) > 0 {
+				secondHighestIdx = i
+			}
+		}
+		secondPrice := bids[secondHighestIdx].Amount
+
+		tx, err := auctioneer.ResolveRound(ctx, testSetup.accounts[0].txOpts, round, bids)
+		require.NoError(t, err)
+		require.NotNil(t, tx)
+		_, err = bind.WaitMined(ctx, testSetup.backend.Client(), tx)
+		require.NoError(t, err)
+
+		winnerAddr := testSetup.accounts[highestIdx+1].accountAddr
+
+		// (a) the highest bidder becomes controller and pays the
+		// second-highest bid amount.
+		for i, acc := range testSetup.accounts[1 : numBidders+1] {
+			bal, err := testSetup.erc20Contract.BalanceOf(&bind.CallOpts{}, acc.accountAddr)
+			require.NoError(t, err)
+			debited := new(big.Int).Sub(startBalances[i], bal)
+			if acc.accountAddr == winnerAddr {
+				require.Equal(t, 0, debited.Cmp(secondPrice), "winner should be charged exactly the second-highest bid")
+			} else {
+				require.Equal(t, 0, debited.Sign(), "losing bidder must not be charged")
+			}
+			// (d) no bidder is ever charged more than their max approval
+			// (the max uint256 granted in setupBidderClient), and no
+			// bidder's balance ever goes up.
+			require.LessOrEqual(t, debited.Cmp(maxApproval), 0)
+			require.GreaterOrEqual(t, debited.Sign(), 0)
+			totalDebited.Add(totalDebited, debited)
+		}
+
+		require.Eventually(t, func() bool {
+			controller, known := seq.Controller(round)
+			return known && controller == winnerAddr
+		}, testRoundDuration, 50*time.Millisecond, "sequencer should learn the resolved controller before the round ends")
+
+		winnerTx := types.NewTransaction(0, testSetup.expressLaneAddr, big.NewInt(0), 21000, big.NewInt(1), nil)
+		require.NoError(t, seq.SubmitTx(round, winnerAddr, winnerTx))
+		winnerBatch := <-seq.Batches()
+		require.Equal(t, ExpressLane, winnerBatch.Lane, "only the round's controller may use the express lane")
+
+		loserIdx := 0
+		if loserIdx == highestIdx {
+			loserIdx = 1
+		}
+		loserAddr := testSetup.accounts[loserIdx+1].accountAddr
+		loserTx := types.NewTransaction(0, testSetup.expressLaneAddr, big.NewInt(0), 21000, big.NewInt(1), nil)
+		require.NoError(t, seq.SubmitTx(round, loserAddr, loserTx))
+		loserBatch := <-seq.Batches()
+		require.Equal(t, FIFOLane, loserBatch.Lane, "non-controllers fall back to the FIFO lane")
+
+		// Let the round actually roll over before the next iteration
+		// picks a fresh wall-clock round.
+		for seq.roundForTimestamp(time.Now()) <= round {
+			time.Sleep(100 * time.Millisecond)
+		}
+	}
+
+	// (c) total ERC20 debited from bidders equals total credited to the
+	// bid receiver.
+	receiverBal, err := testSetup.erc20Contract.BalanceOf(&bind.CallOpts{}, testSetup.bidReceiverAddr)
+	require.NoError(t, err)
+	require.Equal(t, 0, receiverBal.Cmp(totalDebited))
 }
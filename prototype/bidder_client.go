@@ -0,0 +1,135 @@
+package prototype
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/offchainlabs/timeboost-design-docs/bindings"
+)
+
+// BidderClient represents a single participant able to submit bids into
+// an express lane auction. It advertises the chain id it queried from its
+// ethclient at construction time, so a bid it produces can never be
+// replayed against an auction on a different chain.
+type BidderClient struct {
+	name                string
+	wallet              *Wallet
+	client              *ethclient.Client
+	auctionContractAddr common.Address
+	auctionContract     *bindings.ExpressLaneAuction
+	chainId             *big.Int
+	auctioneerEndpoint  *string
+	httpClient          *http.Client
+	nonce               uint64
+}
+
+// NewBidderClient sets up a bidder able to submit bids for the
+// ExpressLaneAuction deployed at auctionContractAddr. auctioneerEndpoint and
+// httpClient are optional; when auctioneerEndpoint is nil, SubmitBid only
+// signs the bid and leaves delivering it on-chain to the caller.
+func NewBidderClient(
+	ctx context.Context,
+	name string,
+	wallet *Wallet,
+	client *ethclient.Client,
+	auctionContractAddr common.Address,
+	auctioneerEndpoint *string,
+	httpClient *http.Client,
+) (*BidderClient, error) {
+	if name == "" {
+		return nil, fmt.Errorf("bidder client name cannot be empty")
+	}
+	chainId, err := client.ChainID(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("could not query chain id: %w", err)
+	}
+	auctionContract, err := bindings.NewExpressLaneAuction(auctionContractAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("could not bind to express lane auction contract: %w", err)
+	}
+	return &BidderClient{
+		name:                name,
+		wallet:              wallet,
+		client:              client,
+		auctionContractAddr: auctionContractAddr,
+		auctionContract:     auctionContract,
+		chainId:             chainId,
+		auctioneerEndpoint:  auctioneerEndpoint,
+		httpClient:          httpClient,
+	}, nil
+}
+
+// ChainId returns the chain id this bidder client advertises bids for.
+func (bc *BidderClient) ChainId() *big.Int {
+	return bc.chainId
+}
+
+// signBid produces a SignedBid for the given round, amount, express lane
+// controller, and deadline, signed over a domain that binds it to this
+// client's chain id and auction contract. Each call uses the next nonce
+// in sequence so an auctioneer can detect replays.
+func (bc *BidderClient) signBid(round uint64, amount *big.Int, expressLaneController common.Address, deadline time.Time) (*SignedBid, error) {
+	bid := &SignedBid{
+		ChainId:               new(big.Int).Set(bc.chainId),
+		Round:                 round,
+		Amount:                new(big.Int).Set(amount),
+		ExpressLaneController: expressLaneController,
+		Nonce:                 atomic.AddUint64(&bc.nonce, 1),
+		Deadline:              uint64(deadline.Unix()),
+	}
+	hash := bidSigningHash(bid, bc.auctionContractAddr)
+	sig, err := crypto.Sign(hash.Bytes(), bc.wallet.PrivKey)
+	if err != nil {
+		return nil, fmt.Errorf("could not sign bid: %w", err)
+	}
+	bid.Signature = sig
+	return bid, nil
+}
+
+// SubmitBid signs a bid for the given round and, if an auctioneer
+// endpoint is configured, delivers it there over HTTP; otherwise it just
+// returns the signed bid for the caller to submit on-chain itself.
+func (bc *BidderClient) SubmitBid(
+	ctx context.Context, round uint64, amount *big.Int, expressLaneController common.Address, deadline time.Time,
+) (*SignedBid, error) {
+	bid, err := bc.signBid(round, amount, expressLaneController, deadline)
+	if err != nil {
+		return nil, err
+	}
+	if bc.auctioneerEndpoint == nil {
+		return bid, nil
+	}
+	payload, err := json.Marshal(bid)
+	if err != nil {
+		return nil, fmt.Errorf("could not marshal bid: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, *bc.auctioneerEndpoint+"/submitBid", bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("could not build submitBid request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	httpClient := bc.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("could not submit bid to auctioneer: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(resp.Body)
+		return nil, fmt.Errorf("auctioneer rejected bid: %s", string(body))
+	}
+	return bid, nil
+}
@@ -0,0 +1,199 @@
+package prototype
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/offchainlabs/timeboost-design-docs/bindings"
+)
+
+// Lane identifies which ordering lane a SequencedBatch was placed into.
+type Lane int
+
+const (
+	// FIFOLane is the regular, first-in-first-out ordering lane available
+	// to every sender.
+	FIFOLane Lane = iota
+	// ExpressLane is the priority lane available only to the round's
+	// resolved auction controller.
+	ExpressLane
+)
+
+// SequencedBatch is the outcome of a single SubmitTx call: which lane a
+// sender's transaction was placed into for a given round.
+type SequencedBatch struct {
+	Round uint64
+	Lane  Lane
+	From  common.Address
+	Tx    *types.Transaction
+}
+
+// Sequencer is a prototype sequencer that subscribes to ExpressLaneAuction
+// AuctionResolved events on a simulated backend and enforces express-lane
+// sequencing: only the round's resolved controller may submit into the
+// express lane. Everyone else, and every sender in a round whose
+// controller never resolved in time, falls back to the regular FIFO lane.
+type Sequencer struct {
+	auctionContract  *bindings.ExpressLaneAuction
+	initialTimestamp time.Time
+	roundDuration    time.Duration
+
+	mu            sync.Mutex
+	controller    map[uint64]common.Address
+	sealedRounds  map[uint64]bool
+	currentRound  uint64
+
+	batches chan SequencedBatch
+}
+
+// NewSequencer constructs a Sequencer watching the given auction contract
+// for resolutions, with rounds computed from initialTimestamp and
+// roundDuration exactly as the auction contract itself computes them.
+func NewSequencer(
+	ctx context.Context,
+	client *ethclient.Client,
+	auctionContractAddr common.Address,
+	initialTimestamp time.Time,
+	roundDuration time.Duration,
+) (*Sequencer, error) {
+	auctionContract, err := bindings.NewExpressLaneAuction(auctionContractAddr, client)
+	if err != nil {
+		return nil, fmt.Errorf("could not bind to express lane auction contract: %w", err)
+	}
+	s := &Sequencer{
+		auctionContract:  auctionContract,
+		initialTimestamp: initialTimestamp,
+		roundDuration:    roundDuration,
+		controller:       make(map[uint64]common.Address),
+		sealedRounds:     make(map[uint64]bool),
+		batches:          make(chan SequencedBatch, 256),
+	}
+	logsCh := make(chan *bindings.ExpressLaneAuctionAuctionResolved, 256)
+	sub, err := auctionContract.WatchAuctionResolved(&bind.WatchOpts{Context: ctx}, logsCh)
+	if err != nil {
+		return nil, fmt.Errorf("could not subscribe to auction resolutions: %w", err)
+	}
+	go s.processResolutions(ctx, sub, logsCh)
+	go s.watchRoundRollover(ctx)
+	return s, nil
+}
+
+// Controller returns the resolved express lane controller for round, if
+// one has been learned from an on-time AuctionResolved event.
+func (s *Sequencer) Controller(round uint64) (common.Address, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	controller, ok := s.controller[round]
+	return controller, ok
+}
+
+// Batches returns the channel of sequencing decisions produced by SubmitTx,
+// so tests and callers can assert on lane ordering.
+func (s *Sequencer) Batches() <-chan SequencedBatch {
+	return s.batches
+}
+
+// SubmitTx sequences tx from the given sender into the express lane if and
+// only if from is the resolved controller of round; otherwise it falls
+// back to the regular FIFO lane.
+func (s *Sequencer) SubmitTx(round uint64, from common.Address, tx *types.Transaction) error {
+	s.mu.Lock()
+	controller, known := s.controller[round]
+	s.mu.Unlock()
+
+	lane := FIFOLane
+	if known && controller == from {
+		lane = ExpressLane
+	}
+	select {
+	case s.batches <- SequencedBatch{Round: round, Lane: lane, From: from, Tx: tx}:
+	default:
+		return fmt.Errorf("sequenced batch channel is full")
+	}
+	return nil
+}
+
+// roundForTimestamp computes the auction round that t falls within, using
+// the same initialTimestamp + roundDuration*n formula as the auction
+// contract.
+func (s *Sequencer) roundForTimestamp(t time.Time) uint64 {
+	if t.Before(s.initialTimestamp) {
+		return 0
+	}
+	return uint64(t.Sub(s.initialTimestamp) / s.roundDuration)
+}
+
+// processResolutions maintains the per-round controller map from
+// AuctionResolved logs. A removed log (from a chain reorg of the auction
+// contract) un-resolves that round rather than leaving a stale controller
+// in place. A resolution that arrives for a round already sealed by
+// watchRoundRollover is a late resolution and is ignored: that round has
+// already fallen back to FIFO for its entire duration.
+func (s *Sequencer) processResolutions(
+	ctx context.Context,
+	sub eventSubscription,
+	logsCh chan *bindings.ExpressLaneAuctionAuctionResolved,
+) {
+	defer sub.Unsubscribe()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case err := <-sub.Err():
+			if err != nil {
+				return
+			}
+		case ev, ok := <-logsCh:
+			if !ok {
+				return
+			}
+			s.mu.Lock()
+			if ev.Raw.Removed {
+				delete(s.controller, ev.Round)
+			} else if !s.sealedRounds[ev.Round] {
+				s.controller[ev.Round] = ev.FirstPriceExpressLaneController
+			}
+			s.mu.Unlock()
+		}
+	}
+}
+
+// watchRoundRollover seals every round once it has fully elapsed if that
+// round's controller never resolved during it, so a late-arriving
+// resolution for it cannot retroactively grant express-lane access after
+// the round is already over. The round currently in progress is left
+// unsealed so a controller that resolves partway through it is still
+// honored for the remainder of the round.
+func (s *Sequencer) watchRoundRollover(ctx context.Context) {
+	ticker := time.NewTicker(time.Second)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			round := s.roundForTimestamp(now)
+			s.mu.Lock()
+			for r := s.currentRound; r < round; r++ {
+				if _, ok := s.controller[r]; !ok {
+					s.sealedRounds[r] = true
+				}
+			}
+			s.currentRound = round
+			s.mu.Unlock()
+		}
+	}
+}
+
+// eventSubscription is the subset of event.Subscription that Sequencer
+// needs, kept narrow so processResolutions is easy to exercise in tests.
+type eventSubscription interface {
+	Err() <-chan error
+	Unsubscribe()
+}
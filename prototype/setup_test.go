@@ -3,7 +3,9 @@ package prototype
 import (
 	"context"
 	"crypto/ecdsa"
+	"fmt"
 	"math/big"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -11,7 +13,10 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/core"
 	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
 	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/params"
 	"github.com/offchainlabs/timeboost-design-docs/bindings"
 	"github.com/stretchr/testify/require"
 )
@@ -28,19 +33,51 @@ type auctionSetup struct {
 	bidReceiverAddr  common.Address
 	accounts         []*testAccount
 	backend          *simulated.Backend
+	commitsPaused    atomic.Bool
+}
+
+// pauseCommits stops the background goroutine from advancing the chain,
+// simulating sequencer downtime: no pending transaction can be mined
+// until resumeCommits is called.
+func (s *auctionSetup) pauseCommits() {
+	s.commitsPaused.Store(true)
+}
+
+// resumeCommits resumes the background goroutine advancing the chain.
+func (s *auctionSetup) resumeCommits() {
+	s.commitsPaused.Store(false)
 }
 
 func setupAuctionTest(t *testing.T, ctx context.Context) *auctionSetup {
-	accs, backend := setupAccounts(10)
+	return setupAuctionTestForChain(t, ctx, 1337)
+}
 
-	// Advance the chain in the background
+// setupAuctionTestForChain is identical to setupAuctionTest but deploys the
+// simulated backend with the given chain id, so tests can exercise an
+// auctioneer that accepts bids for more than one chain.
+func setupAuctionTestForChain(t *testing.T, ctx context.Context, chainID uint64) *auctionSetup {
+	return setupAuctionTestForChainAndRound(t, ctx, chainID, time.Minute)
+}
+
+// setupAuctionTestForChainAndRound is identical to setupAuctionTestForChain
+// but lets a test pick a shorter round duration than the contract's usual
+// one minute, so a test that needs to observe several real round
+// rollovers doesn't have to run for minutes at a time.
+func setupAuctionTestForChainAndRound(t *testing.T, ctx context.Context, chainID uint64, roundDuration time.Duration) *auctionSetup {
+	accs, backend := setupAccountsForChain(10, chainID)
+	setup := &auctionSetup{backend: backend}
+
+	// Advance the chain in the background, unless commits are paused to
+	// simulate sequencer downtime.
 	go func() {
 		tick := time.NewTicker(time.Second)
 		defer tick.Stop()
 		for {
 			select {
 			case <-tick.C:
-				backend.Commit()
+				if !setup.commitsPaused.Load() {
+					backend.Commit()
+				}
 			case <-ctx.Done():
 				return
 			}
@@ -73,7 +110,7 @@ func setupAuctionTest(t *testing.T, ctx context.Context) *auctionSetup {
 
 	expressLaneAddr := common.HexToAddress("0x2424242424242424242424242424242424242424")
 	bidReceiverAddr := common.HexToAddress("0x3424242424242424242424242424242424242424")
-	bidRoundSeconds := uint64(60)
+	bidRoundSeconds := uint64(roundDuration.Seconds())
 
 	// Calculate the number of seconds until the next minute
 	// and the next timestamp that is a multiple of a minute.
@@ -91,19 +128,17 @@ func setupAuctionTest(t *testing.T, ctx context.Context) *auctionSetup {
 	if _, err = bind.WaitMined(ctx, backend.Client(), tx); err != nil {
 		t.Fatal(err)
 	}
-	return &auctionSetup{
-		chainId:          chainId,
-		auctioneerAddr:   auctionContractAddr,
-		auctionContract:  auctionContract,
-		erc20Addr:        erc20Addr,
-		erc20Contract:    erc20,
-		initialTimestamp: now,
-		roundDuration:    time.Minute,
-		expressLaneAddr:  expressLaneAddr,
-		bidReceiverAddr:  bidReceiverAddr,
-		accounts:         accs,
-		backend:          backend,
-	}
+	setup.chainId = chainId
+	setup.auctioneerAddr = auctionContractAddr
+	setup.auctionContract = auctionContract
+	setup.erc20Addr = erc20Addr
+	setup.erc20Contract = erc20
+	setup.initialTimestamp = now
+	setup.roundDuration = roundDuration
+	setup.expressLaneAddr = expressLaneAddr
+	setup.bidReceiverAddr = bidReceiverAddr
+	setup.accounts = accs
+	return setup
 }
 
 func setupBidderClient(
@@ -147,18 +182,30 @@ type testAccount struct {
 }
 
 func setupAccounts(numAccounts uint64) ([]*testAccount, *simulated.Backend) {
+	return setupAccountsForChain(numAccounts, 1337)
+}
+
+// setupAccountsForChain is identical to setupAccounts but deploys the
+// simulated backend's genesis with the given chain id, so a test can run
+// several distinctly-chain-identified backends side by side. Account keys
+// are derived deterministically from their index rather than generated
+// at random, so the same index produces the same address on every
+// backend: deploying the same sequence of contracts from accounts[0]
+// against two such backends therefore yields identical contract
+// addresses, letting a test model one shared auction contract
+// deployment across chains.
+func setupAccountsForChain(numAccounts uint64, chainID uint64) ([]*testAccount, *simulated.Backend) {
 	genesis := make(core.GenesisAlloc)
 	gasLimit := uint64(100000000)
 
 	accs := make([]*testAccount, numAccounts)
 	for i := uint64(0); i < numAccounts; i++ {
-		privKey, err := crypto.GenerateKey()
+		privKey, err := deterministicTestKey(i)
 		if err != nil {
 			panic(err)
 		}
 		addr := crypto.PubkeyToAddress(privKey.PublicKey)
-		chainID := big.NewInt(1337)
-		txOpts, err := bind.NewKeyedTransactorWithChainID(privKey, chainID)
+		txOpts, err := bind.NewKeyedTransactorWithChainID(privKey, new(big.Int).SetUint64(chainID))
 		if err != nil {
 			panic(err)
 		}
@@ -173,10 +220,32 @@ func setupAccounts(numAccounts uint64) ([]*testAccount, *simulated.Backend) {
 			privKey:     privKey,
 		}
 	}
-	backend := simulated.NewBackend(genesis, simulated.WithBlockGasLimit(gasLimit))
+	backend := simulated.NewBackend(
+		genesis,
+		simulated.WithBlockGasLimit(gasLimit),
+		withChainID(chainID),
+	)
 	return accs, backend
 }
 
+// deterministicTestKey derives a stable private key for test account i,
+// independent of any particular backend's chain id.
+func deterministicTestKey(i uint64) (*ecdsa.PrivateKey, error) {
+	seed := crypto.Keccak256([]byte(fmt.Sprintf("timeboost-prototype-test-account-%d", i)))
+	return crypto.ToECDSA(seed)
+}
+
+// withChainID overrides the simulated backend's genesis chain config, since
+// the package only exposes WithBlockGasLimit/WithCallGasLimit/
+// WithMinerMinTip options and otherwise hardcodes the simulated chain id.
+func withChainID(chainID uint64) func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+	return func(nodeConf *node.Config, ethConf *ethconfig.Config) {
+		cfg := *params.AllDevChainProtocolChanges
+		cfg.ChainID = new(big.Int).SetUint64(chainID)
+		ethConf.Genesis.Config = &cfg
+	}
+}
+
 func mintTokens(ctx context.Context,
 	opts *bind.TransactOpts,
 	backend *simulated.Backend,
@@ -184,7 +253,10 @@ func mintTokens(ctx context.Context,
 	erc20 *bindings.MockERC20,
 ) {
 	for i := 0; i < len(accs); i++ {
-		tx, err := erc20.Mint(opts, accs[i].accountAddr, big.NewInt(10))
+		// Minted well above any plausible cumulative bid total across a
+		// multi-round simulation, so a bidder who wins several rounds in
+		// a row is never left unable to cover the next round's charge.
+		tx, err := erc20.Mint(opts, accs[i].accountAddr, big.NewInt(10_000))
 		if err != nil {
 			panic(err)
 		}
@@ -0,0 +1,15 @@
+package prototype
+
+import (
+	"crypto/ecdsa"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+)
+
+// Wallet bundles the transaction signing options and raw private key a
+// bidder or auctioneer uses to interact with the express lane auction
+// contract and to sign off-chain bid payloads.
+type Wallet struct {
+	TxOpts  *bind.TransactOpts
+	PrivKey *ecdsa.PrivateKey
+}